@@ -4,7 +4,7 @@
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
-//     http://www.apache.org/licenses/LICENSE-2.0
+//	http://www.apache.org/licenses/LICENSE-2.0
 //
 // Unless required by applicable law or agreed to in writing, software
 // distributed under the License is distributed on an "AS IS" BASIS,
@@ -16,8 +16,9 @@
 package assertions
 
 import (
-	"log"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -29,12 +30,16 @@ const (
 
 type Condition int
 
-// Assertion is an assertion.
+// Assertion is a condition-based assertion.
+//
+// Deprecated: use That instead, which supports more than nil checks.
 type Assertion struct {
 	t *testing.T
 }
 
 // New creates a new test assertion.
+//
+// Deprecated: use That instead.
 func New(t *testing.T) *Assertion {
 	return &Assertion{
 		t: t,
@@ -43,20 +48,117 @@ func New(t *testing.T) *Assertion {
 
 // Assert tests a condition.
 // If the condition is not met, the test will error and fail in-place.
+//
+// Deprecated: use That instead.
 func (a *Assertion) Assert(condition string, actual interface{}, expected ...interface{}) bool {
 	switch condition {
 	case IsNil:
-		if !assert.Nil(a.t, actual) {
-			log.Fatalf("Expected nil, got %v", actual)
-			return false
-		}
+		return assert.Nil(a.t, actual)
 	case IsNotNil:
-		if !assert.NotNil(a.t, actual) {
-			log.Fatal("Expected value to not be nil, but it was!")
-			return false
-		}
+		return assert.NotNil(a.t, actual)
 	default:
 		return false
 	}
-	return true
+}
+
+// TestingT is the subset of *testing.T that Assertions needs. It's satisfied by
+// *testing.T itself; tests of this package substitute a fake to observe failures.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// Assertions is a fluent assertion on a single value, created with That. Every matcher
+// fails the test via t.Errorf (or t.Fatalf when actual or expected cannot be evaluated at
+// all, e.g. ErrorIs on a non-error or JSONEquals on invalid JSON) and returns the receiver so calls
+// can be chained.
+type Assertions struct {
+	t      TestingT
+	actual interface{}
+}
+
+// That begins a fluent assertion on actual, e.g. assertions.That(t, actual).Equals(expected).
+func That(t TestingT, actual interface{}) *Assertions {
+	t.Helper()
+	return &Assertions{t: t, actual: actual}
+}
+
+// Equals asserts that actual == expected, printing a colored unified diff on mismatch.
+func (a *Assertions) Equals(expected interface{}) *Assertions {
+	a.t.Helper()
+	if !assert.ObjectsAreEqual(a.actual, expected) {
+		a.t.Errorf("values are not equal:\n%s", diff(expected, a.actual))
+	}
+	return a
+}
+
+// DeepEquals asserts that actual and expected are deeply equal (comparing across types
+// where convertible, e.g. int(1) and int64(1)), printing a colored unified diff on mismatch.
+func (a *Assertions) DeepEquals(expected interface{}) *Assertions {
+	a.t.Helper()
+	if !assert.ObjectsAreEqualValues(a.actual, expected) {
+		a.t.Errorf("values are not deeply equal:\n%s", diff(expected, a.actual))
+	}
+	return a
+}
+
+// Contains asserts that actual contains element: a substring, a map key, or a slice/array element.
+func (a *Assertions) Contains(element interface{}) *Assertions {
+	a.t.Helper()
+	assert.Contains(a.t, a.actual, element)
+	return a
+}
+
+// HasLen asserts that actual has length n. actual must be an array, slice, map, string or channel.
+func (a *Assertions) HasLen(n int) *Assertions {
+	a.t.Helper()
+	assert.Len(a.t, a.actual, n)
+	return a
+}
+
+// ErrorIs asserts that actual is an error whose chain contains target, per errors.Is.
+func (a *Assertions) ErrorIs(target error) *Assertions {
+	a.t.Helper()
+	err, ok := a.actual.(error)
+	if !ok && a.actual != nil {
+		a.t.Fatalf("ErrorIs: actual (%#v) is not an error", a.actual)
+		return a
+	}
+	assert.ErrorIs(a.t, err, target)
+	return a
+}
+
+// JSONEquals asserts that actual marshals to JSON structurally equal to expected, printing
+// a colored unified diff on mismatch.
+func (a *Assertions) JSONEquals(expected string) *Assertions {
+	a.t.Helper()
+	actualJSON, err := json.Marshal(a.actual)
+	if err != nil {
+		a.t.Fatalf("JSONEquals: failed to marshal actual: %v", err)
+		return a
+	}
+
+	var expectedVal, actualVal interface{}
+	if err := json.Unmarshal([]byte(expected), &expectedVal); err != nil {
+		a.t.Fatalf("JSONEquals: failed to unmarshal expected: %v", err)
+		return a
+	}
+	if err := json.Unmarshal(actualJSON, &actualVal); err != nil {
+		a.t.Fatalf("JSONEquals: failed to unmarshal actual: %v", err)
+		return a
+	}
+
+	if !assert.ObjectsAreEqualValues(expectedVal, actualVal) {
+		a.t.Errorf("JSON values are not equal:\n%s", diff(expectedVal, actualVal))
+	}
+	return a
+}
+
+// Eventually asserts that fn returns true within timeout, polling every interval. Useful
+// for waiting on asynchronous state instead of a fixed sleep.
+func (a *Assertions) Eventually(fn func() bool, timeout, interval time.Duration) *Assertions {
+	a.t.Helper()
+	assert.Eventually(a.t, fn, timeout, interval)
+	return a
 }
@@ -0,0 +1,181 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assertions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeT is a minimal TestingT that records failures instead of failing the real test, so
+// we can assert on the failure paths of the fluent matchers.
+type fakeT struct {
+	errors []string
+	fatals []string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.fatals = append(f.fatals, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) failed() bool {
+	return len(f.errors) > 0 || len(f.fatals) > 0
+}
+
+func TestAssertEqualsFailure(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, "actual").Equals("expected")
+
+	if !ft.failed() {
+		t.Fatal("expected Equals to fail for mismatched values")
+	}
+	if !strings.Contains(ft.errors[0], `"expected"`) || !strings.Contains(ft.errors[0], `"actual"`) {
+		t.Fatalf("expected a unified diff in the failure message, got: %s", ft.errors[0])
+	}
+}
+
+func TestAssertEqualsSuccess(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, "same").Equals("same")
+
+	if ft.failed() {
+		t.Fatalf("expected Equals to pass for identical values, got: %v %v", ft.errors, ft.fatals)
+	}
+}
+
+func TestAssertDeepEqualsFailure(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, []int{1, 2}).DeepEquals([]int{1, 3})
+
+	if !ft.failed() {
+		t.Fatal("expected DeepEquals to fail for mismatched slices")
+	}
+}
+
+func TestAssertContainsFailure(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, []string{"a", "b"}).Contains("c")
+
+	if !ft.failed() {
+		t.Fatal("expected Contains to fail when the element is absent")
+	}
+}
+
+func TestAssertHasLenFailure(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, []int{1, 2, 3}).HasLen(2)
+
+	if !ft.failed() {
+		t.Fatal("expected HasLen to fail for a mismatched length")
+	}
+}
+
+func TestAssertErrorIsFailure(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, errors.New("boom")).ErrorIs(errors.New("other"))
+
+	if !ft.failed() {
+		t.Fatal("expected ErrorIs to fail for an unrelated error")
+	}
+}
+
+func TestAssertErrorIsSuccess(t *testing.T) {
+	target := errors.New("boom")
+	wrapped := fmt.Errorf("wrapping: %w", target)
+
+	ft := &fakeT{}
+	That(ft, wrapped).ErrorIs(target)
+
+	if ft.failed() {
+		t.Fatalf("expected ErrorIs to pass when target is in the chain, got: %v %v", ft.errors, ft.fatals)
+	}
+}
+
+func TestAssertErrorIsNotAnError(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, "not an error").ErrorIs(errors.New("boom"))
+
+	if len(ft.fatals) != 1 {
+		t.Fatalf("expected ErrorIs to Fatalf when actual isn't an error, got: %v", ft.fatals)
+	}
+}
+
+func TestAssertJSONEqualsFailure(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, map[string]int{"a": 1}).JSONEquals(`{"a":2}`)
+
+	if !ft.failed() {
+		t.Fatal("expected JSONEquals to fail for mismatched JSON")
+	}
+}
+
+func TestAssertJSONEqualsSuccess(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, map[string]int{"a": 1}).JSONEquals(`{"a":1}`)
+
+	if ft.failed() {
+		t.Fatalf("expected JSONEquals to pass for equivalent JSON, got: %v %v", ft.errors, ft.fatals)
+	}
+}
+
+func TestAssertJSONEqualsInvalidExpected(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, map[string]int{"a": 1}).JSONEquals(`not json`)
+
+	if len(ft.fatals) != 1 {
+		t.Fatalf("expected JSONEquals to Fatalf on invalid expected JSON, got: %v", ft.fatals)
+	}
+}
+
+func TestAssertEventuallyFailure(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, nil).Eventually(func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond)
+
+	if !ft.failed() {
+		t.Fatal("expected Eventually to fail when the condition never becomes true")
+	}
+}
+
+func TestAssertEventuallySuccess(t *testing.T) {
+	ft := &fakeT{}
+	That(ft, nil).Eventually(func() bool { return true }, 20*time.Millisecond, 5*time.Millisecond)
+
+	if ft.failed() {
+		t.Fatalf("expected Eventually to pass when the condition is already true, got: %v %v", ft.errors, ft.fatals)
+	}
+}
+
+func TestDeprecatedAssert(t *testing.T) {
+	a := New(t)
+
+	if !a.Assert(IsNil, nil) {
+		t.Fatal("expected Assert(IsNil, nil) to pass")
+	}
+	if !a.Assert(IsNotNil, "value") {
+		t.Fatal("expected Assert(IsNotNil, \"value\") to pass")
+	}
+	if a.Assert("unknown-condition", nil) {
+		t.Fatal("expected Assert to return false for an unknown condition")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assertions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const (
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorReset = "\x1b[0m"
+)
+
+// diff renders a colored unified diff between expected and actual, formatting both with
+// "%#v" so it's readable for any comparable type, not just strings.
+func diff(expected, actual interface{}) string {
+	expectedText := fmt.Sprintf("%#v", expected)
+	actualText := fmt.Sprintf("%#v", actual)
+
+	unified := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(expectedText),
+		B:        difflib.SplitLines(actualText),
+		FromFile: "expected",
+		ToFile:   "actual",
+		Context:  3,
+	}
+	text, err := difflib.GetUnifiedDiffString(unified)
+	if err != nil {
+		return fmt.Sprintf("expected: %s\nactual:   %s", expectedText, actualText)
+	}
+	return colorize(text)
+}
+
+// colorize highlights removed lines in red and added lines in green.
+func colorize(unifiedDiff string) string {
+	lines := strings.Split(unifiedDiff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			lines[i] = colorRed + line + colorReset
+		case strings.HasPrefix(line, "+"):
+			lines[i] = colorGreen + line + colorReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -4,18 +4,21 @@ package config
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	_ "github.com/spf13/viper/remote"
 )
 
 // Manager is the configuration manager.
 type Manager struct {
-	name  string
-	flags *pflag.FlagSet
-	viper *viper.Viper
+	name    string
+	flags   *pflag.FlagSet
+	viper   *viper.Viper
+	secrets SecretResolver
 }
 
 // New returns a new initialized manager with the given config.
@@ -50,6 +53,24 @@ func (mgr *Manager) ReadFromFile(fs *pflag.FlagSet) error {
 	return nil
 }
 
+// AddRemoteProvider registers a remote key/value config source, e.g. etcd or Consul.
+// Call ReadRemoteConfig to fetch and merge it in. See viper.AddRemoteProvider for the
+// supported provider names and path formats.
+func (mgr *Manager) AddRemoteProvider(provider, endpoint, path string) error {
+	return mgr.viper.AddRemoteProvider(provider, endpoint, path)
+}
+
+// ReadRemoteConfig fetches the config from the providers added with AddRemoteProvider.
+func (mgr *Manager) ReadRemoteConfig() error {
+	return mgr.viper.ReadRemoteConfig()
+}
+
+// SetSecretResolver registers the resolver used to resolve `secret://` values during
+// Unmarshal. Without one, a `secret://` value fails to unmarshal.
+func (mgr *Manager) SetSecretResolver(resolver SecretResolver) {
+	mgr.secrets = resolver
+}
+
 // InitFlags initializes the flagset with the provided config.
 func (mgr *Manager) InitFlags(cfg any) error {
 	rootStruct := reflect.TypeOf(cfg)
@@ -80,14 +101,18 @@ func (mgr *Manager) Unmarshal(config interface{}) error {
 		Result:  config,
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			stringSliceToStringMapHookFunc,
+			refValueHookFunc(mgr.secrets),
 		),
 	})
 	if err != nil {
 		return err
 	}
 
-	decoder.Decode(mgr.viper.AllSettings())
-	return nil
+	if err := decoder.Decode(mgr.viper.AllSettings()); err != nil {
+		return err
+	}
+
+	return validateStruct("", reflect.Indirect(reflect.ValueOf(config)))
 }
 
 // Viper returns viper.
@@ -108,6 +133,7 @@ func (mgr *Manager) parseStructToFlags(prefix string, strT reflect.Type) {
 
 		desc := field.Tag.Get("description")
 		short := field.Tag.Get("short")
+		def := field.Tag.Get("default")
 
 		if prefix != "" {
 			name = prefix + "." + name
@@ -115,21 +141,31 @@ func (mgr *Manager) parseStructToFlags(prefix string, strT reflect.Type) {
 
 		switch kind {
 		case reflect.String:
-			mgr.flags.StringP(name, short, "", desc)
+			mgr.flags.StringP(name, short, def, desc)
 		case reflect.Bool:
-			mgr.flags.BoolP(name, short, false, desc)
+			b, _ := strconv.ParseBool(def)
+			mgr.flags.BoolP(name, short, b, desc)
 		case reflect.Uint:
-			mgr.flags.UintP(name, short, 0, desc)
+			n, _ := strconv.ParseUint(def, 10, 64)
+			mgr.flags.UintP(name, short, uint(n), desc)
 		case reflect.Uint64:
-			mgr.flags.Uint64P(name, short, 0, desc)
+			n, _ := strconv.ParseUint(def, 10, 64)
+			mgr.flags.Uint64P(name, short, n, desc)
 		case reflect.Int:
-			mgr.flags.IntP(name, short, 0, desc)
+			n, _ := strconv.Atoi(def)
+			mgr.flags.IntP(name, short, n, desc)
 		case reflect.Int64:
-			mgr.flags.Int64P(name, short, 0, desc)
+			n, _ := strconv.ParseInt(def, 10, 64)
+			mgr.flags.Int64P(name, short, n, desc)
 		case reflect.Float64:
-			mgr.flags.Float64P(name, short, 0, desc)
+			n, _ := strconv.ParseFloat(def, 64)
+			mgr.flags.Float64P(name, short, n, desc)
 		case reflect.Slice:
-			mgr.flags.StringSliceP(name, short, nil, desc)
+			var defs []string
+			if def != "" {
+				defs = strings.Split(def, ",")
+			}
+			mgr.flags.StringSliceP(name, short, defs, desc)
 		case reflect.Struct:
 			// This allows for recursion
 			mgr.parseStructToFlags(name, field.Type)
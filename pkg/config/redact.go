@@ -0,0 +1,59 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "reflect"
+
+// redacted is the placeholder value used for fields tagged `secret:"true"`.
+const redacted = "REDACTED"
+
+// Redacted returns cfg's effective values as a dotted-path map, with any field tagged
+// `secret:"true"` replaced by the placeholder "REDACTED". Use it to log a service's
+// effective configuration at startup without leaking credentials.
+func (mgr *Manager) Redacted(cfg interface{}) map[string]any {
+	out := make(map[string]any)
+	collectRedacted("", reflect.Indirect(reflect.ValueOf(cfg)), out)
+	return out
+}
+
+func collectRedacted(prefix string, v reflect.Value, out map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("name")
+		if (name == "" || name == "-") && field.Type.Kind() != reflect.Struct {
+			continue
+		}
+
+		path := name
+		if prefix != "" && name != "" {
+			path = prefix + "." + name
+		} else if prefix != "" {
+			path = prefix
+		}
+
+		fv := v.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			collectRedacted(path, fv, out)
+			continue
+		}
+
+		if field.Tag.Get("secret") == "true" {
+			out[path] = redacted
+			continue
+		}
+		out[path] = fv.Interface()
+	}
+}
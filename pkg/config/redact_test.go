@@ -0,0 +1,58 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type redactTestConfig struct {
+	Name     string `name:"name"`
+	Password string `name:"password" secret:"true"`
+	Nested   struct {
+		APIKey string `name:"api_key" secret:"true"`
+		Region string `name:"region"`
+	} `name:"nested"`
+}
+
+func TestCollectRedactedMasksSecrets(t *testing.T) {
+	cfg := redactTestConfig{Name: "svc", Password: "hunter2"}
+	cfg.Nested.APIKey = "s3cr3t"
+	cfg.Nested.Region = "us-east-1"
+
+	out := make(map[string]any)
+	collectRedacted("", reflect.ValueOf(cfg), out)
+
+	assert.Equal(t, "svc", out["name"])
+	assert.Equal(t, redacted, out["password"])
+	assert.Equal(t, redacted, out["nested.api_key"])
+	assert.Equal(t, "us-east-1", out["nested.region"])
+}
+
+func TestManagerRedacted(t *testing.T) {
+	cfg := redactTestConfig{Name: "svc", Password: "hunter2"}
+	cfg.Nested.APIKey = "s3cr3t"
+	cfg.Nested.Region = "us-east-1"
+
+	mgr := &Manager{}
+	out := mgr.Redacted(&cfg)
+
+	assert.Equal(t, "svc", out["name"])
+	assert.Equal(t, redacted, out["password"])
+	assert.Equal(t, redacted, out["nested.api_key"])
+}
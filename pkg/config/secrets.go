@@ -0,0 +1,60 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// SecretResolver resolves a named secret, e.g. from Vault or another secret store.
+// Register one with Manager.SetSecretResolver to support `secret://` values.
+type SecretResolver interface {
+	ResolveSecret(name string) (string, error)
+}
+
+// refValueHookFunc is a mapstructure decode hook that resolves string values of the form
+// `file:///path`, `env://VAR` and `secret://name` to the file's contents, the environment
+// variable's value, and the value fetched from resolver, respectively. This lets services
+// keep non-sensitive config in YAML while pulling credentials from mounted secret files or
+// a secret store without them ever appearing in flags or env dumps. Values that don't match
+// one of these forms pass through unchanged.
+func refValueHookFunc(resolver SecretResolver) func(reflect.Type, reflect.Type, interface{}) (interface{}, error) {
+	return func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.String || t.Kind() != reflect.String {
+			return data, nil
+		}
+		s := data.(string)
+		switch {
+		case strings.HasPrefix(s, "file://"):
+			b, err := os.ReadFile(strings.TrimPrefix(s, "file://"))
+			if err != nil {
+				return nil, fmt.Errorf("config: failed to read %v: %w", s, err)
+			}
+			return strings.TrimSpace(string(b)), nil
+		case strings.HasPrefix(s, "env://"):
+			return os.Getenv(strings.TrimPrefix(s, "env://")), nil
+		case strings.HasPrefix(s, "secret://"):
+			if resolver == nil {
+				return nil, fmt.Errorf("config: %v requires a SecretResolver, none registered", s)
+			}
+			return resolver.ResolveSecret(strings.TrimPrefix(s, "secret://"))
+		default:
+			return data, nil
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSecretResolver map[string]string
+
+func (f fakeSecretResolver) ResolveSecret(name string) (string, error) {
+	v, ok := f[name]
+	if !ok {
+		return "", errors.New("no such secret")
+	}
+	return v, nil
+}
+
+func TestRefValueHookFuncFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	assert.Nil(t, os.WriteFile(path, []byte("s3cr3t\n"), 0o600))
+
+	hook := refValueHookFunc(nil)
+	stringType := reflect.TypeOf("")
+
+	v, err := hook(stringType, stringType, "file://"+path)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", v)
+}
+
+func TestRefValueHookFuncFileMissing(t *testing.T) {
+	hook := refValueHookFunc(nil)
+	stringType := reflect.TypeOf("")
+
+	_, err := hook(stringType, stringType, "file:///does/not/exist")
+	assert.NotNil(t, err)
+}
+
+func TestRefValueHookFuncEnv(t *testing.T) {
+	t.Setenv("CONFIG_TEST_VAR", "from-env")
+
+	hook := refValueHookFunc(nil)
+	stringType := reflect.TypeOf("")
+
+	v, err := hook(stringType, stringType, "env://CONFIG_TEST_VAR")
+	assert.Nil(t, err)
+	assert.Equal(t, "from-env", v)
+}
+
+func TestRefValueHookFuncSecret(t *testing.T) {
+	hook := refValueHookFunc(fakeSecretResolver{"db-password": "hunter2"})
+	stringType := reflect.TypeOf("")
+
+	v, err := hook(stringType, stringType, "secret://db-password")
+	assert.Nil(t, err)
+	assert.Equal(t, "hunter2", v)
+}
+
+func TestRefValueHookFuncSecretWithoutResolver(t *testing.T) {
+	hook := refValueHookFunc(nil)
+	stringType := reflect.TypeOf("")
+
+	_, err := hook(stringType, stringType, "secret://db-password")
+	assert.NotNil(t, err)
+}
+
+func TestRefValueHookFuncPassthrough(t *testing.T) {
+	hook := refValueHookFunc(nil)
+	stringType := reflect.TypeOf("")
+
+	v, err := hook(stringType, stringType, "plain-value")
+	assert.Nil(t, err)
+	assert.Equal(t, "plain-value", v)
+}
+
+func TestRefValueHookFuncNonString(t *testing.T) {
+	hook := refValueHookFunc(nil)
+	stringType := reflect.TypeOf("")
+	intType := reflect.TypeOf(0)
+
+	v, err := hook(intType, stringType, 42)
+	assert.Nil(t, err)
+	assert.Equal(t, 42, v)
+
+	v, err = hook(stringType, intType, "file://irrelevant")
+	assert.Nil(t, err)
+	assert.Equal(t, "file://irrelevant", v)
+}
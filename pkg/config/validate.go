@@ -0,0 +1,145 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidationError aggregates the validation failures found while validating a config
+// struct, so callers see every failing field at once instead of stopping at the first.
+type ValidationError struct {
+	Errors []error
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// validateStruct walks a config struct and runs the `validate` tag rules on its leaf
+// fields, returning a *ValidationError naming each failure by its full dotted flag path,
+// or nil if everything passed.
+func validateStruct(prefix string, v reflect.Value) error {
+	t := v.Type()
+	var errs []error
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("name")
+		if (name == "" || name == "-") && field.Type.Kind() != reflect.Struct {
+			continue
+		}
+
+		path := name
+		if prefix != "" && name != "" {
+			path = prefix + "." + name
+		} else if prefix != "" {
+			path = prefix
+		}
+
+		fv := v.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			if err := validateStruct(path, fv); err != nil {
+				errs = append(errs, err.(*ValidationError).Errors...)
+			}
+			continue
+		}
+
+		if rules := field.Tag.Get("validate"); rules != "" {
+			errs = append(errs, validateField(path, rules, fv)...)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: errs}
+}
+
+// validateField applies a comma-separated `validate` tag, e.g. "required,min=1,max=65535,oneof=dev prod".
+func validateField(path, rules string, v reflect.Value) (errs []error) {
+	for _, rule := range strings.Split(rules, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch name {
+		case "required":
+			if v.IsZero() {
+				errs = append(errs, fmt.Errorf("%s is required", path))
+			}
+		case "min":
+			if err := checkBound(path, "min", arg, v, false); err != nil {
+				errs = append(errs, err)
+			}
+		case "max":
+			if err := checkBound(path, "max", arg, v, true); err != nil {
+				errs = append(errs, err)
+			}
+		case "oneof":
+			options := strings.Fields(arg)
+			actual := fmt.Sprintf("%v", v.Interface())
+			found := false
+			for _, opt := range options {
+				if opt == actual {
+					found = true
+					break
+				}
+			}
+			if !found {
+				errs = append(errs, fmt.Errorf("%s must be one of %v, got %q", path, options, actual))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%s: unknown validation rule %q", path, name))
+		}
+	}
+	return errs
+}
+
+// checkBound enforces a min or max numeric bound. For strings, slices and maps, the bound
+// applies to their length rather than their value.
+func checkBound(path, rule, arg string, v reflect.Value, isMax bool) error {
+	bound, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return fmt.Errorf("%s: invalid %s bound %q", path, rule, arg)
+	}
+
+	var actual float64
+	switch v.Kind() {
+	case reflect.String:
+		actual = float64(len(v.String()))
+	case reflect.Slice, reflect.Map:
+		actual = float64(v.Len())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		actual = v.Float()
+	default:
+		return fmt.Errorf("%s: %s is not supported for kind %s", path, rule, v.Kind())
+	}
+
+	if isMax && actual > bound {
+		return fmt.Errorf("%s must be at most %v, got %v", path, bound, actual)
+	}
+	if !isMax && actual < bound {
+		return fmt.Errorf("%s must be at least %v, got %v", path, bound, actual)
+	}
+	return nil
+}
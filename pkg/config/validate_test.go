@@ -0,0 +1,101 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validateTestConfig struct {
+	Name   string `name:"name" validate:"required"`
+	Port   int    `name:"port" validate:"min=1,max=65535"`
+	Env    string `name:"env" validate:"oneof=dev prod"`
+	Nested struct {
+		Level string `name:"level" validate:"required"`
+	} `name:"nested"`
+}
+
+func TestValidateStructRequired(t *testing.T) {
+	cfg := validateTestConfig{Port: 80, Env: "dev"}
+	cfg.Nested.Level = "debug"
+
+	err := validateStruct("", reflect.ValueOf(cfg))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name is required")
+}
+
+func TestValidateStructPasses(t *testing.T) {
+	cfg := validateTestConfig{Name: "svc", Port: 80, Env: "dev"}
+	cfg.Nested.Level = "debug"
+
+	assert.Nil(t, validateStruct("", reflect.ValueOf(cfg)))
+}
+
+func TestValidateStructNestedError(t *testing.T) {
+	cfg := validateTestConfig{Name: "svc", Port: 80, Env: "dev"}
+
+	err := validateStruct("", reflect.ValueOf(cfg))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "nested.level is required")
+}
+
+func TestValidateFieldMin(t *testing.T) {
+	errs := validateField("port", "min=10", reflect.ValueOf(5))
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "must be at least 10")
+}
+
+func TestValidateFieldMax(t *testing.T) {
+	errs := validateField("port", "max=10", reflect.ValueOf(20))
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "must be at most 10")
+}
+
+func TestValidateFieldOneofMatches(t *testing.T) {
+	errs := validateField("env", "oneof=dev prod", reflect.ValueOf("dev"))
+	assert.Len(t, errs, 0)
+}
+
+func TestValidateFieldOneofNoMatch(t *testing.T) {
+	errs := validateField("env", "oneof=dev prod", reflect.ValueOf("staging"))
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), `must be one of`)
+}
+
+func TestValidateFieldUnknownRule(t *testing.T) {
+	errs := validateField("name", "bogus", reflect.ValueOf("value"))
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), `unknown validation rule "bogus"`)
+}
+
+func TestCheckBoundMalformed(t *testing.T) {
+	err := checkBound("port", "min", "not-a-number", reflect.ValueOf(5), false)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid min bound")
+}
+
+func TestCheckBoundUnsupportedKind(t *testing.T) {
+	err := checkBound("flag", "min", "1", reflect.ValueOf(true), false)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not supported for kind")
+}
+
+func TestCheckBoundStringLength(t *testing.T) {
+	assert.Nil(t, checkBound("name", "min", "2", reflect.ValueOf("ab"), false))
+	assert.NotNil(t, checkBound("name", "min", "3", reflect.ValueOf("ab"), false))
+}
@@ -0,0 +1,83 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// String constructs a string-valued field.
+func String(key, val string) zap.Field {
+	return zap.String(key, val)
+}
+
+// Int constructs an int-valued field.
+func Int(key string, val int) zap.Field {
+	return zap.Int(key, val)
+}
+
+// Bool constructs a bool-valued field.
+func Bool(key string, val bool) zap.Field {
+	return zap.Bool(key, val)
+}
+
+// Duration constructs a time.Duration-valued field.
+func Duration(key string, val time.Duration) zap.Field {
+	return zap.Duration(key, val)
+}
+
+// Time constructs a time.Time-valued field.
+func Time(key string, val time.Time) zap.Field {
+	return zap.Time(key, val)
+}
+
+// Err constructs a field for an error under the conventional "error" key.
+func Err(err error) zap.Field {
+	return zap.Error(err)
+}
+
+// Any constructs a field from val's runtime type. Prefer the typed helpers above
+// (String, Int, Bool, ...) where the type is known at the call site; Any is for the
+// dynamic cases like Fields and F that aren't.
+func Any(key string, val interface{}) zap.Field {
+	return zap.Any(key, val)
+}
+
+// F constructs a field for val, a type known at compile time, routing to the typed
+// helper for it where one exists and falling back to Any otherwise.
+func F[T any](key string, val T) zap.Field {
+	switch v := any(val).(type) {
+	case string:
+		return String(key, v)
+	case int:
+		return Int(key, v)
+	case int64:
+		return zap.Int64(key, v)
+	case float64:
+		return zap.Float64(key, v)
+	case bool:
+		return Bool(key, v)
+	case time.Duration:
+		return Duration(key, v)
+	case time.Time:
+		return Time(key, v)
+	case error:
+		return Err(v)
+	default:
+		return Any(key, v)
+	}
+}
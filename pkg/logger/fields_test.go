@@ -0,0 +1,47 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestF(t *testing.T) {
+	assert.Equal(t, String("key", "value"), F("key", "value"))
+	assert.Equal(t, Int("key", 1), F("key", 1))
+	assert.Equal(t, Bool("key", true), F("key", true))
+	assert.Equal(t, Duration("key", time.Second), F("key", time.Second))
+	assert.Equal(t, Err(errors.New("boom")), F[error]("error", errors.New("boom")))
+
+	// Regression: the old reflect-based field() silently dropped float64 and read
+	// Field.Interface instead of the float bits zap expects.
+	assert.Equal(t, zap.Float64("key", 1.5), F("key", 1.5))
+}
+
+func TestFields(t *testing.T) {
+	fields := Fields(
+		"str", "value",
+		"int", 1,
+		"malformed-pair-without-a-value",
+	)
+	assert.Len(t, fields, 2)
+	assert.Equal(t, String("str", "value"), fields[0])
+	assert.Equal(t, Int("int", 1), fields[1])
+}
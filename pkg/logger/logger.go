@@ -17,33 +17,27 @@ package logger
 
 import (
 	"context"
-	"reflect"
+	"net/http"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
-var reflectTypeToZapFieldType = map[reflect.Kind]zapcore.FieldType{
-	reflect.String: zapcore.StringType,
-	reflect.Int64:  zapcore.Int64Type,
-	reflect.Int:    zapcore.Int64Type,
-}
-
 type loggerKeyType string
 
 var loggerKey loggerKeyType = "logger"
 
 // Logger wraps zap.Logger.
 type Logger struct {
-	ctx    context.Context
+	ctx context.Context
+	// Level is the Logger's current level. It can be changed at runtime with SetLevel,
+	// or via the http.Handler returned by LevelHandler, without rebuilding the Logger.
+	// Sinks configured with an explicit Sink.Level are unaffected by it.
+	Level  zap.AtomicLevel
 	logger *zap.Logger
 	fields []zap.Field
 }
 
-// Options is the logger options.
-type Options struct {
-}
-
 // Field represents a logger field.
 type Field struct {
 	Key   string
@@ -55,7 +49,7 @@ type Field struct {
 func Fields(a ...any) (fields []zapcore.Field) {
 	for i := 0; i < len(a)-1; i = i + 2 {
 		if str, ok := a[i].(string); ok {
-			fields = append(fields, field(str, a[i+1]))
+			fields = append(fields, F(str, a[i+1]))
 		}
 	}
 	return
@@ -63,34 +57,61 @@ func Fields(a ...any) (fields []zapcore.Field) {
 
 // New creates a new logger. Make sure to call defer logger.Clean() after calling this.
 // Log messages are JSON Encoded and timestamps are RFC3339 encoded.
-func New(ctx context.Context, debug bool) (*Logger, error) {
-	config := zap.NewProductionConfig()
-	config.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
+//
+// By default, logs are written to stderr only. Pass an Options with Sinks set to also
+// write rotated log files or forward to syslog; each sink's core is combined with the
+// others via zapcore.NewTee.
+func New(ctx context.Context, debug bool, opts ...Options) (*Logger, error) {
+	var opt Options
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	level := zap.InfoLevel
 	cfg := zapcore.EncoderConfig{
-		EncodeTime:    zapcore.RFC3339TimeEncoder,
-		MessageKey:    "msg",
-		TimeKey:       "ts",
-		CallerKey:     "caller",
-		StacktraceKey: "trace",
+		EncodeTime:     zapcore.RFC3339TimeEncoder,
+		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeDuration: zapcore.SecondsDurationEncoder,
+		MessageKey:     "msg",
+		TimeKey:        "ts",
+		CallerKey:      "",
+	}
+	if debug {
+		level = zap.DebugLevel
+		cfg.CallerKey = "caller"
+		cfg.EncodeCaller = zapcore.ShortCallerEncoder
+		cfg.StacktraceKey = "trace"
 	}
-	if !debug {
-		config.DisableStacktrace = true
-		config.DisableCaller = true
-		config.Level = zap.NewAtomicLevelAt(zap.InfoLevel)
-		cfg.CallerKey = ""
+	encoder := zapcore.NewJSONEncoder(cfg)
+	atomicLevel := zap.NewAtomicLevelAt(level)
+
+	sinks := opt.Sinks
+	if len(sinks) == 0 {
+		sinks = []Sink{{Kind: SinkStderr}}
 	}
 
-	zap.RegisterEncoder("custom", func(ec zapcore.EncoderConfig) (zapcore.Encoder, error) {
-		return zapcore.NewJSONEncoder(cfg), nil
-	})
-	config.Encoding = "custom"
-	logger, err := config.Build()
-	if err != nil {
-		return nil, err
+	cores := make([]zapcore.Core, 0, len(sinks))
+	for _, s := range sinks {
+		ws, err := s.writeSyncer()
+		if err != nil {
+			return nil, err
+		}
+		core := zapcore.NewCore(encoder, ws, s.levelEnabler(atomicLevel))
+		if opt.Sampling != nil {
+			core = zapcore.NewSamplerWithOptions(core, opt.Sampling.Tick, opt.Sampling.Initial, opt.Sampling.Thereafter)
+		}
+		cores = append(cores, core)
 	}
+
+	zopts := []zap.Option{}
+	if debug {
+		zopts = append(zopts, zap.AddCaller(), zap.AddStacktrace(zap.ErrorLevel))
+	}
+
 	return &Logger{
 		ctx:    ctx,
-		logger: logger,
+		Level:  atomicLevel,
+		logger: zap.New(zapcore.NewTee(cores...), zopts...),
 		fields: make([]zap.Field, 0),
 	}, nil
 }
@@ -100,6 +121,23 @@ func (l *Logger) Clean() {
 	l.logger.Sync()
 }
 
+// SetLevel changes the Logger's level at runtime. It accepts the same names as
+// zapcore.Level.UnmarshalText, e.g. "debug", "info", "warn", "error".
+func (l *Logger) SetLevel(level string) error {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return err
+	}
+	l.Level.SetLevel(lvl)
+	return nil
+}
+
+// LevelHandler returns an http.Handler that GETs and PUTs the Logger's current level as
+// JSON, e.g. `{"level":"info"}`. See zap.AtomicLevel.ServeHTTP.
+func (l *Logger) LevelHandler() http.Handler {
+	return l.Level
+}
+
 // NewContextWithLogger returns a new context with a logger and panics if it doesn't match the interface.
 func NewContextWithLogger(parentCtx context.Context, logger *Logger) context.Context {
 	if logger == nil {
@@ -143,30 +181,13 @@ func (l *Logger) Fatal(msg string) {
 	l.logger.With(l.fields...).Fatal(msg)
 }
 
-func field(key string, val interface{}) (field zapcore.Field) {
-	kind := reflect.TypeOf(val).Kind()
-	zapType := reflectTypeToZapFieldType[kind]
-	switch kind {
-	case reflect.String:
-		field = zap.Field{Key: key, String: val.(string), Type: zapType}
-	case reflect.Int:
-		field = zap.Field{Key: key, Integer: int64(val.(int)), Type: zapType}
-	case reflect.Int64:
-		field = zap.Field{Key: key, Integer: int64(val.(int)), Type: zapType}
-	case reflect.Float64:
-		field = zap.Field{Key: key, Interface: float64(val.(float64)), Type: zapType}
-	default:
-		// Skip this since we don't know the type
-	}
-	return
-}
-
 // WithField returns a new logger with the provided field.
 func (l *Logger) WithField(key string, val interface{}) *Logger {
 	return &Logger{
 		ctx:    l.ctx,
+		Level:  l.Level,
 		logger: l.logger,
-		fields: append(l.fields, field(key, val)),
+		fields: append(l.fields, F(key, val)),
 	}
 }
 
@@ -174,6 +195,7 @@ func (l *Logger) WithField(key string, val interface{}) *Logger {
 func (l *Logger) WithFields(f []zapcore.Field) *Logger {
 	return &Logger{
 		ctx:    l.ctx,
+		Level:  l.Level,
 		logger: l.logger,
 		fields: append(l.fields, f...),
 	}
@@ -16,9 +16,14 @@ package logger
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestLogger(t *testing.T) {
@@ -47,3 +52,59 @@ func TestLogger(t *testing.T) {
 		"test-other-key", 1,
 	)).Info("This is an info message with multiple field")
 }
+
+func TestLoggerSinks(t *testing.T) {
+	ctx := context.Background()
+	logFile := filepath.Join(t.TempDir(), "test.log")
+
+	logger, err := New(ctx, false, Options{
+		Sinks: []Sink{
+			{Kind: SinkStderr},
+			{Kind: SinkFile, File: &FileSink{
+				Path:       logFile,
+				MaxSizeMB:  1,
+				MaxAgeDays: 1,
+				MaxBackups: 1,
+			}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, logger)
+	defer logger.Clean()
+
+	logger.Info("This is an info message written to stderr and a rotated file")
+}
+
+func TestLoggerSetLevel(t *testing.T) {
+	ctx := context.Background()
+
+	logger, err := New(ctx, false)
+	assert.Nil(t, err)
+	defer logger.Clean()
+
+	assert.False(t, logger.Level.Enabled(zapcore.DebugLevel))
+	assert.Nil(t, logger.SetLevel("debug"))
+	assert.True(t, logger.Level.Enabled(zapcore.DebugLevel))
+	assert.NotNil(t, logger.SetLevel("not-a-level"))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	logger.LevelHandler().ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.Contains(rec.Body.String(), "debug"))
+}
+
+func TestLoggerWithFieldPropagatesLevel(t *testing.T) {
+	ctx := context.Background()
+
+	logger, err := New(ctx, false)
+	assert.Nil(t, err)
+	defer logger.Clean()
+
+	child := logger.WithField("test", "value")
+	assert.Nil(t, child.SetLevel("debug"))
+	assert.True(t, logger.Level.Enabled(zapcore.DebugLevel))
+
+	child = logger.WithFields(Fields("test-key", "test-value"))
+	assert.True(t, child.Level.Enabled(zapcore.DebugLevel))
+}
@@ -1,13 +1,148 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 package logger
 
-import "net/http"
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MiddlewareOptions configures Logger.Middleware.
+type MiddlewareOptions struct {
+	// RequestIDHeader is the inbound header carrying a caller-supplied request ID.
+	// Defaults to "X-Request-Id".
+	RequestIDHeader string
+	// TraceparentHeader is the W3C trace context header consulted for a trace ID when
+	// RequestIDHeader is absent, so the correlation ID lines up with OpenTelemetry spans.
+	// Defaults to "traceparent".
+	TraceparentHeader string
+	// AccessLogLevel is the level the access log line is emitted at: "debug", "info",
+	// "warn" or "error". Defaults to "info".
+	AccessLogLevel string
+	// SkipPaths are request paths excluded from the child logger and access log, e.g. "/healthz".
+	SkipPaths []string
+}
+
+// Middleware returns a middleware that attaches a per-request child logger - pre-populated
+// with method, path, remote_addr, user_agent and a correlation ID - to the request context,
+// and emits a structured access log line once the request completes.
+//
+// The correlation ID is read from MiddlewareOptions.RequestIDHeader, falling back to the
+// trace ID in MiddlewareOptions.TraceparentHeader, and finally to a generated UUID.
+func (logger *Logger) Middleware(opts ...MiddlewareOptions) func(http.Handler) http.Handler {
+	var opt MiddlewareOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	requestIDHeader := opt.RequestIDHeader
+	if requestIDHeader == "" {
+		requestIDHeader = "X-Request-Id"
+	}
+	traceparentHeader := opt.TraceparentHeader
+	if traceparentHeader == "" {
+		traceparentHeader = "traceparent"
+	}
+	skipPaths := make(map[string]bool, len(opt.SkipPaths))
+	for _, p := range opt.SkipPaths {
+		skipPaths[p] = true
+	}
 
-// Middleware is a middleware that adds the logger to the request context.
-func (logger *Logger) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ctx := NewContextWithLogger(r.Context(), logger)
-			next.ServeHTTP(w, r.WithContext(ctx))
+			if skipPaths[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			requestID := r.Header.Get(requestIDHeader)
+			if requestID == "" {
+				requestID = traceIDFromTraceparent(r.Header.Get(traceparentHeader))
+			}
+			if requestID == "" {
+				requestID = uuid.NewString()
+			}
+
+			child := logger.WithFields(Fields(
+				"method", r.Method,
+				"path", r.URL.Path,
+				"remote_addr", r.RemoteAddr,
+				"user_agent", r.UserAgent(),
+				"request_id", requestID,
+			))
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			ctx := NewContextWithLogger(r.Context(), child)
+
+			start := time.Now()
+			next.ServeHTTP(sw, r.WithContext(ctx))
+
+			accessLogger := child.WithFields(Fields(
+				"status", sw.status,
+				"bytes", sw.bytes,
+				"duration", time.Since(start),
+			))
+			logAtLevel(accessLogger, opt.AccessLogLevel, "Handled request")
 		})
 	}
 }
+
+// statusWriter wraps http.ResponseWriter to capture the status code and bytes written,
+// since net/http doesn't expose either after the fact.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+// WriteHeader records the status code before delegating.
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the number of bytes written before delegating.
+func (w *statusWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// traceIDFromTraceparent extracts the trace ID from a W3C traceparent header value
+// ("version-traceid-spanid-flags"), returning "" if it's malformed or absent.
+func traceIDFromTraceparent(traceparent string) string {
+	parts := strings.Split(traceparent, "-")
+	if len(parts) != 4 || len(parts[1]) != 32 {
+		return ""
+	}
+	return parts[1]
+}
+
+// logAtLevel emits msg on l at the named level, falling back to Info for an unknown or
+// empty level.
+func logAtLevel(l *Logger, level, msg string) {
+	switch level {
+	case "debug":
+		l.Debug(msg)
+	case "warn":
+		l.Warn(msg)
+	case "error":
+		l.Error(msg)
+	default:
+		l.Info(msg)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.Background()
+	logger, err := New(ctx, false)
+	assert.Nil(t, err)
+	defer logger.Clean()
+
+	var childLogger *Logger
+	handler := logger.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		childLogger = NewLoggerFromContext(r.Context())
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte("ok"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/brew", nil)
+	req.Header.Set("X-Request-Id", "req-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+	assert.Equal(t, "ok", rec.Body.String())
+	assert.NotNil(t, childLogger)
+}
+
+func TestMiddlewareSkipPaths(t *testing.T) {
+	ctx := context.Background()
+	logger, err := New(ctx, false)
+	assert.Nil(t, err)
+	defer logger.Clean()
+
+	called := false
+	handler := logger.Middleware(MiddlewareOptions{SkipPaths: []string{"/healthz"}})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestTraceIDFromTraceparent(t *testing.T) {
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736",
+		traceIDFromTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+	assert.Equal(t, "", traceIDFromTraceparent(""))
+	assert.Equal(t, "", traceIDFromTraceparent("not-a-traceparent"))
+}
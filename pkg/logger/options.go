@@ -0,0 +1,131 @@
+// Copyright © 2022 Krishna Iyer Easwaran
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"log/syslog"
+	"os"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// SinkKind is the kind of destination a Sink writes to.
+type SinkKind string
+
+const (
+	// SinkStderr writes to stderr.
+	SinkStderr SinkKind = "stderr"
+	// SinkFile writes to a rotated log file on disk.
+	SinkFile SinkKind = "file"
+	// SinkSyslog writes to a syslog daemon.
+	SinkSyslog SinkKind = "syslog"
+)
+
+// FileSink configures a rotating file sink, backed by lumberjack.
+type FileSink struct {
+	// Path is the file to write logs to.
+	Path string
+	// MaxSizeMB is the maximum size in megabytes of the log file before it gets rotated.
+	MaxSizeMB int
+	// MaxAgeDays is the maximum number of days to retain old log files.
+	MaxAgeDays int
+	// MaxBackups is the maximum number of old log files to retain.
+	MaxBackups int
+	// Compress determines if the rotated log files should be gzip compressed.
+	Compress bool
+}
+
+// SyslogSink configures a syslog sink.
+type SyslogSink struct {
+	// Network is the network to dial, e.g. "udp" or "tcp". Empty dials the local syslog daemon.
+	Network string
+	// Addr is the syslog daemon address. Empty dials the local syslog daemon.
+	Addr string
+	// Tag identifies the logging process in syslog messages.
+	Tag string
+}
+
+// Sink configures a single log output destination and the minimum level it accepts.
+type Sink struct {
+	// Kind selects the destination for this sink.
+	Kind SinkKind
+	// Level is the minimum level written to this sink. Defaults to the Logger's level.
+	Level *zapcore.Level
+	// File configures the sink when Kind is SinkFile.
+	File *FileSink
+	// Syslog configures the sink when Kind is SinkSyslog.
+	Syslog *SyslogSink
+}
+
+// SamplingConfig reduces the volume of repeated log entries.
+// See zapcore.NewSamplerWithOptions for the semantics of Initial and Thereafter:
+// of every Tick, the first Initial entries with a given message are logged, and
+// every Thereafter-th entry after that, the rest are dropped.
+type SamplingConfig struct {
+	Initial    int
+	Thereafter int
+	Tick       time.Duration
+}
+
+// Options configures a Logger.
+type Options struct {
+	// Sinks are the log output destinations. If empty, logs are written to stderr only.
+	Sinks []Sink
+	// Sampling, if set, caps the volume of repeated log entries.
+	Sampling *SamplingConfig
+}
+
+// writeSyncer returns the zapcore.WriteSyncer for the sink.
+func (s Sink) writeSyncer() (zapcore.WriteSyncer, error) {
+	switch s.Kind {
+	case "", SinkStderr:
+		return zapcore.AddSync(os.Stderr), nil
+	case SinkFile:
+		if s.File == nil {
+			return nil, fmt.Errorf("logger: file sink requires a FileSink configuration")
+		}
+		return zapcore.AddSync(&lumberjack.Logger{
+			Filename:   s.File.Path,
+			MaxSize:    s.File.MaxSizeMB,
+			MaxAge:     s.File.MaxAgeDays,
+			MaxBackups: s.File.MaxBackups,
+			Compress:   s.File.Compress,
+		}), nil
+	case SinkSyslog:
+		if s.Syslog == nil {
+			return nil, fmt.Errorf("logger: syslog sink requires a SyslogSink configuration")
+		}
+		w, err := syslog.Dial(s.Syslog.Network, s.Syslog.Addr, syslog.LOG_INFO, s.Syslog.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("logger: failed to dial syslog: %w", err)
+		}
+		return zapcore.AddSync(w), nil
+	default:
+		return nil, fmt.Errorf("logger: unknown sink kind %q", s.Kind)
+	}
+}
+
+// levelEnabler returns the LevelEnabler for the sink. Sinks with an explicit Level are
+// pinned to it; the rest track def, so adjusting def at runtime (e.g. via Logger.SetLevel)
+// changes their verbosity too.
+func (s Sink) levelEnabler(def zapcore.LevelEnabler) zapcore.LevelEnabler {
+	if s.Level == nil {
+		return def
+	}
+	return *s.Level
+}